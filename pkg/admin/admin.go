@@ -0,0 +1,99 @@
+/*
+ * Copyright 2022-2025 The Seal Authors.
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+*/
+
+// Package admin exposes a small HTTP endpoint alongside the MySQL
+// listener so orchestrators can probe the process without speaking
+// the wire protocol.
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+
+	"github.com/sealdb/seal/version"
+)
+
+// Server is the admin HTTP listener. The zero value is not usable;
+// construct one with NewServer.
+type Server struct {
+	httpServer *http.Server
+	// Ready is consulted by /readyz; it should return false until the
+	// server is accepting MySQL connections. A nil Ready always
+	// reports ready.
+	Ready func() bool
+}
+
+// NewServer builds an admin Server listening on addr (e.g.
+// "127.0.0.1:8081"). Call Serve to start accepting connections.
+func NewServer(addr string) *Server {
+	s := &Server{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/version", s.handleVersion)
+	mux.HandleFunc("/variables", s.handleVariables)
+	mux.HandleFunc("/healthz", s.handleHealthz)
+	mux.HandleFunc("/readyz", s.handleReadyz)
+	s.httpServer = &http.Server{Addr: addr, Handler: mux}
+	return s
+}
+
+// Serve starts accepting connections and blocks until the server is
+// closed. It returns http.ErrServerClosed on a clean Shutdown.
+func (s *Server) Serve() error {
+	return s.httpServer.ListenAndServe()
+}
+
+// ServeOn is like Serve but accepts an already-open listener, useful
+// when the caller wants to bind the port before forking off Serve in
+// a goroutine.
+func (s *Server) ServeOn(l net.Listener) error {
+	return s.httpServer.Serve(l)
+}
+
+// Shutdown gracefully stops the admin listener.
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
+
+func (s *Server) handleVersion(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(version.GetVersion())
+}
+
+// handleVariables exposes the same name/value pairs a connected MySQL
+// client would get back from `SHOW VARIABLES LIKE 'version%'`, until
+// this tree has a connection handler to answer that query over the
+// wire protocol itself.
+func (s *Server) handleVariables(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(version.GetVersion().VersionVariables())
+}
+
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if s.Ready != nil && !s.Ready() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("not ready"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
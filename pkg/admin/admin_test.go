@@ -0,0 +1,82 @@
+/*
+ * Copyright 2022-2025 The Seal Authors.
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+*/
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sealdb/seal/version"
+)
+
+func TestHandleVersion(t *testing.T) {
+	s := NewServer("127.0.0.1:0")
+
+	rec := httptest.NewRecorder()
+	s.handleVersion(rec, httptest.NewRequest(http.MethodGet, "/version", nil))
+
+	var got version.Version
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("decoding /version response: %v", err)
+	}
+	if got.ProjectName != "sealdb" {
+		t.Errorf("ProjectName = %q, want %q", got.ProjectName, "sealdb")
+	}
+}
+
+func TestHandleVariables(t *testing.T) {
+	s := NewServer("127.0.0.1:0")
+
+	rec := httptest.NewRecorder()
+	s.handleVariables(rec, httptest.NewRequest(http.MethodGet, "/variables", nil))
+
+	var got map[string]string
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("decoding /variables response: %v", err)
+	}
+	if _, ok := got["version"]; !ok {
+		t.Errorf("/variables response missing %q, got %v", "version", got)
+	}
+	if _, ok := got["version_comment"]; !ok {
+		t.Errorf("/variables response missing %q, got %v", "version_comment", got)
+	}
+}
+
+func TestHandleHealthz(t *testing.T) {
+	s := NewServer("127.0.0.1:0")
+
+	rec := httptest.NewRecorder()
+	s.handleHealthz(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("/healthz status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestHandleReadyz(t *testing.T) {
+	s := NewServer("127.0.0.1:0")
+	s.Ready = func() bool { return false }
+
+	rec := httptest.NewRecorder()
+	s.handleReadyz(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("/readyz status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2022-2025 The Seal Authors.
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sealdb/seal/sqlparser"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Parse is a context-aware drop-in for sqlparser.Parse. Callers on the
+// query path should use this instead of calling sqlparser.Parse
+// directly so the parse step shows up as a child span of whatever
+// span ctx is carrying.
+func Parse(ctx context.Context, sql string) (sqlparser.Statement, error) {
+	ctx, span := tracer().Start(ctx, "sqlparser.Parse", trace.WithAttributes(
+		attribute.String("db.system", "mysql"),
+		attribute.String("db.statement", getRedactor()(sql)),
+	))
+	defer span.End()
+
+	stmt, err := sqlparser.Parse(sql)
+	finishParseSpan(span, stmt, err)
+	return stmt, err
+}
+
+// ParseStrictDDL is the context-aware drop-in for
+// sqlparser.ParseStrictDDL.
+func ParseStrictDDL(ctx context.Context, sql string) (sqlparser.Statement, error) {
+	ctx, span := tracer().Start(ctx, "sqlparser.ParseStrictDDL", trace.WithAttributes(
+		attribute.String("db.system", "mysql"),
+		attribute.String("db.statement", getRedactor()(sql)),
+	))
+	defer span.End()
+
+	stmt, err := sqlparser.ParseStrictDDL(sql)
+	finishParseSpan(span, stmt, err)
+	return stmt, err
+}
+
+func finishParseSpan(span trace.Span, stmt sqlparser.Statement, err error) {
+	if err != nil {
+		class := classifyParseError(err)
+		span.SetAttributes(attribute.String("db.parse_error_class", class.String()))
+		span.SetStatus(codes.Error, class.String())
+		span.RecordError(err)
+		return
+	}
+	span.SetAttributes(attribute.String("sqlparser.statement_type", fmt.Sprintf("%T", stmt)))
+}
+
+// ParseErrorClass buckets a parse error for the db.parse_error_class
+// span attribute, so dashboards can group failures without parsing
+// error strings.
+type ParseErrorClass int
+
+const (
+	ParseErrorUnknown ParseErrorClass = iota
+	ParseErrorEmpty
+	ParseErrorSyntax
+)
+
+func (c ParseErrorClass) String() string {
+	switch c {
+	case ParseErrorEmpty:
+		return "empty"
+	case ParseErrorSyntax:
+		return "syntax"
+	default:
+		return "unknown"
+	}
+}
+
+func classifyParseError(err error) ParseErrorClass {
+	switch {
+	case err == sqlparser.ErrEmpty:
+		return ParseErrorEmpty
+	case strings.Contains(err.Error(), "syntax error"):
+		return ParseErrorSyntax
+	default:
+		return ParseErrorUnknown
+	}
+}
+
+// StartSpan opens a span for a planning or execution step (e.g. the
+// logical planner or the backend MySQL round-trip) so non-parser
+// stages of the query path show up in the same trace. Callers should
+// defer span.End().
+func StartSpan(ctx context.Context, name string, kv ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer().Start(ctx, name, trace.WithAttributes(kv...))
+}
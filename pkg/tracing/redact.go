@@ -0,0 +1,63 @@
+/*
+ * Copyright 2022-2025 The Seal Authors.
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+*/
+
+package tracing
+
+import (
+	"regexp"
+	"sync/atomic"
+)
+
+// Redactor scrubs a raw SQL statement before it is attached to a span
+// as the db.statement attribute. Implementations should be safe to
+// call from multiple goroutines.
+type Redactor func(sql string) string
+
+// activeRedactor holds the Redactor installed by the most recent call
+// to Init, behind an atomic.Value so a config reload racing with an
+// in-flight Parse/ParseStrictDDL call isn't a data race. It defaults
+// to DefaultRedactor so spans created before Init (or in tests that
+// never call it) still scrub literals.
+var activeRedactor atomic.Value // holds a Redactor
+
+func init() {
+	activeRedactor.Store(Redactor(DefaultRedactor))
+}
+
+// getRedactor returns the currently installed Redactor.
+func getRedactor() Redactor {
+	return activeRedactor.Load().(Redactor)
+}
+
+// setRedactor installs r as the active Redactor.
+func setRedactor(r Redactor) {
+	activeRedactor.Store(r)
+}
+
+var (
+	stringLiteralRE  = regexp.MustCompile(`'(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*"`)
+	numericLiteralRE = regexp.MustCompile(`\b\d+(\.\d+)?\b`)
+)
+
+// DefaultRedactor replaces quoted string literals and bare numeric
+// literals with `?`, mirroring how a prepared-statement placeholder
+// would look, so db.statement is safe to export without leaking row
+// data.
+func DefaultRedactor(sql string) string {
+	sql = stringLiteralRE.ReplaceAllString(sql, "?")
+	sql = numericLiteralRE.ReplaceAllString(sql, "?")
+	return sql
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2022-2025 The Seal Authors.
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+*/
+
+package tracing
+
+import "testing"
+
+func TestDefaultRedactor(t *testing.T) {
+	testcases := []struct {
+		input  string
+		output string
+	}{
+		{
+			input:  "select 1",
+			output: "select ?",
+		}, {
+			input:  "select * from t where name = 'alice'",
+			output: "select * from t where name = ?",
+		}, {
+			input:  `select * from t where name = "alice" and age = 30`,
+			output: "select * from t where name = ? and age = ?",
+		}, {
+			input:  "select * from t where id = 7 and name = 'bob'",
+			output: "select * from t where id = ? and name = ?",
+		}, {
+			input:  "select * from t",
+			output: "select * from t",
+		},
+	}
+	for _, testcase := range testcases {
+		if got := DefaultRedactor(testcase.input); got != testcase.output {
+			t.Errorf("DefaultRedactor(%q) = %q, want %q", testcase.input, got, testcase.output)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2022-2025 The Seal Authors.
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+*/
+
+// Package tracing wires sealdb's query path into OpenTelemetry, the
+// same way the upstream OpenCensus/Spanner examples thread spans
+// through their query/update helpers. Parse and ParseStrictDDL wrap
+// the parser today. This tree has no planner or backend MySQL
+// execution stage yet, so there's nothing real to wrap there; once
+// one exists, it should open its span with StartSpan under the same
+// ctx as the surrounding Parse call so a single query still traces
+// end-to-end through the proxy.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is used as the tracer name for every span this
+// package creates, so it shows up as a single logical instrumentation
+// scope in any backend.
+const instrumentationName = "github.com/sealdb/seal/pkg/tracing"
+
+// Exporter selects where finished spans are sent.
+type Exporter string
+
+const (
+	// ExporterNone disables tracing: Init installs the Redactor and a
+	// no-op TracerProvider, and builds no exporter.
+	ExporterNone Exporter = "none"
+	// ExporterStdout prints spans as JSON, useful for local debugging.
+	ExporterStdout Exporter = "stdout"
+	// ExporterOTLP ships spans to a collector over OTLP/gRPC.
+	ExporterOTLP Exporter = "otlp"
+)
+
+// Config describes the tracing setup passed to Init. It is a plain
+// struct filled in by the caller: no config file, flag or environment
+// variable is read into it, and server/main.go builds it inline.
+type Config struct {
+	// ServiceName is reported as the `service.name` resource attribute.
+	ServiceName string
+	// Exporter selects the span destination. Defaults to ExporterNone.
+	Exporter Exporter
+	// OTLPEndpoint is the collector address, e.g. "localhost:4317".
+	// Only used when Exporter == ExporterOTLP.
+	OTLPEndpoint string
+	// OTLPInsecure disables TLS on the OTLP/gRPC connection.
+	OTLPInsecure bool
+	// SampleRatio is the fraction of traces recorded, in [0, 1].
+	// A ratio of 0 or an unset Config samples nothing; 1 samples
+	// every trace.
+	SampleRatio float64
+	// Redactor scrubs literals out of captured SQL text before it is
+	// attached to a span as db.statement. DefaultRedactor is used
+	// when this is nil.
+	Redactor Redactor
+}
+
+// Init builds and installs the global TracerProvider described by cfg,
+// returning a shutdown func the caller must invoke (typically via
+// defer) to flush pending spans before the process exits.
+func Init(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if cfg.Redactor == nil {
+		cfg.Redactor = DefaultRedactor
+	}
+	setRedactor(cfg.Redactor)
+
+	if cfg.Exporter == "" || cfg.Exporter == ExporterNone {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exp, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("tracing: building %s exporter: %w", cfg.Exporter, err)
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceNameKey.String(serviceName(cfg)),
+			semconv.DBSystemMySQL,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("tracing: building resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("tracing: unknown exporter %q", cfg.Exporter)
+	}
+}
+
+func serviceName(cfg Config) string {
+	if cfg.ServiceName != "" {
+		return cfg.ServiceName
+	}
+	return "sealdb"
+}
+
+// tracer is the single instrumentation scope every span in this
+// package is created under.
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
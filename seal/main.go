@@ -17,16 +17,31 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"os"
 
+	"github.com/sealdb/seal/pkg/tracing"
 	"github.com/sealdb/seal/sqlparser"
 	"github.com/sealdb/seal/version"
 )
 
 func main() {
-	fmt.Println(*version.GetBanner())
+	version.DefaultBannerRegistry().WriteTo(os.Stdout, version.BannerNameFromEnv())
 	fmt.Printf("version: [%+v]\n", version.GetVersion())
 
+	ctx := context.Background()
+	shutdown, err := tracing.Init(ctx, tracing.Config{
+		ServiceName: "seal-demo",
+		Exporter:    tracing.ExporterStdout,
+		SampleRatio: 1,
+	})
+	if err != nil {
+		fmt.Printf("tracing: %v\n", err)
+	} else {
+		defer shutdown(ctx)
+	}
+
 	testcases := []struct {
 		input  string
 		output string
@@ -56,12 +71,21 @@ func main() {
 		},
 	}
 	for _, testcase := range testcases {
-		res, err := sqlparser.Parse(testcase.input)
-		fmt.Printf("testcase.err: %v, err: %v\n", testcase.err, err)
-		fmt.Printf("output: %v, res_str: %s\n", testcase.output, sqlparser.String(res))
+		func() {
+			// StartSpan stands in for the planning and backend
+			// MySQL execution stages this demo doesn't have yet;
+			// it makes the parse spans below its children instead
+			// of each being its own trace.
+			spanCtx, span := tracing.StartSpan(ctx, "query.process")
+			defer span.End()
+
+			res, err := tracing.Parse(spanCtx, testcase.input)
+			fmt.Printf("testcase.err: %v, err: %v\n", testcase.err, err)
+			fmt.Printf("output: %v, res_str: %s\n", testcase.output, sqlparser.String(res))
 
-		res, err = sqlparser.ParseStrictDDL(testcase.input)
-		fmt.Printf("testcase.err: %v, err: %v\n", testcase.err, err)
-		fmt.Printf("output: %v, res_str: %s\n", testcase.output, sqlparser.String(res))
+			res, err = tracing.ParseStrictDDL(spanCtx, testcase.input)
+			fmt.Printf("testcase.err: %v, err: %v\n", testcase.err, err)
+			fmt.Printf("output: %v, res_str: %s\n", testcase.output, sqlparser.String(res))
+		}()
 	}
 }
@@ -1,11 +1,26 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"os"
+
+	"github.com/sealdb/seal/pkg/admin"
+	"github.com/sealdb/seal/pkg/tracing"
 	"github.com/sealdb/seal/sqlparser"
 	"github.com/sealdb/seal/version"
 )
 
+// adminAddr is where the admin HTTP listener (/version, /variables,
+// /healthz, /readyz) binds. This will move into the server config system once
+// one exists.
+const adminAddr = "127.0.0.1:8081"
+
+func init() {
+	version.DefaultBannerRegistry().Register("sealdb", banner)
+}
+
 const banner string = `
           _____                    _____                    _____                    _____            _____                    _____          
          /\    \                  /\    \                  /\    \                  /\    \          /\    \                  /\    \         
@@ -31,8 +46,30 @@ const banner string = `
 `
 
 func main() {
-	fmt.Println(banner)
-	fmt.Printf("version: [%+v]\n", version.GetVersion())
+	version.DefaultBannerRegistry().WriteTo(os.Stdout, version.BannerNameFromEnv())
+	v := version.GetVersion()
+	fmt.Printf("version: [%+v]\n", v)
+	fmt.Printf("server_version: %s\n", v.ServerVersionString())
+	log.Printf("server_version is not wired into a MySQL handshake: this tree has no MySQL connection listener yet, so no client ever receives it over the wire")
+
+	ctx := context.Background()
+	shutdownTracing, err := tracing.Init(ctx, tracing.Config{
+		ServiceName: "sealdb-server",
+		Exporter:    tracing.ExporterStdout,
+		SampleRatio: 1,
+	})
+	if err != nil {
+		log.Printf("tracing: %v", err)
+	} else {
+		defer shutdownTracing(ctx)
+	}
+
+	adminServer := admin.NewServer(adminAddr)
+	go func() {
+		if err := adminServer.Serve(); err != nil {
+			log.Printf("admin server stopped: %v", err)
+		}
+	}()
 
 	testcases := []struct {
 		input  string
@@ -63,12 +100,21 @@ func main() {
 		},
 	}
 	for _, testcase := range testcases {
-		res, err := sqlparser.Parse(testcase.input)
-		fmt.Printf("testcase.err: %v, err: %v\n", testcase.err, err)
-		fmt.Printf("output: %v, res_str: %s\n", testcase.output, sqlparser.String(res))
+		func() {
+			// StartSpan stands in for the planning and backend
+			// MySQL execution stages this demo doesn't have yet;
+			// it makes the parse spans below its children instead
+			// of each being its own trace.
+			spanCtx, span := tracing.StartSpan(ctx, "query.process")
+			defer span.End()
+
+			res, err := tracing.Parse(spanCtx, testcase.input)
+			fmt.Printf("testcase.err: %v, err: %v\n", testcase.err, err)
+			fmt.Printf("output: %v, res_str: %s\n", testcase.output, sqlparser.String(res))
 
-		res, err = sqlparser.ParseStrictDDL(testcase.input)
-		fmt.Printf("testcase.err: %v, err: %v\n", testcase.err, err)
-		fmt.Printf("output: %v, res_str: %s\n", testcase.output, sqlparser.String(res))
+			res, err = tracing.ParseStrictDDL(spanCtx, testcase.input)
+			fmt.Printf("testcase.err: %v, err: %v\n", testcase.err, err)
+			fmt.Printf("output: %v, res_str: %s\n", testcase.output, sqlparser.String(res))
+		}()
 	}
 }
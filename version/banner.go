@@ -17,13 +17,146 @@
 package version
 
 import (
+	"bytes"
+	"io"
 	"math/rand"
+	"os"
+	"sync"
+	"text/template"
 	"time"
+
+	"golang.org/x/term"
+)
+
+// Banner name constants recognized by SEALDB_BANNER and by
+// BannerRegistry.Render.
+const (
+	BannerRandom = "random"
+	BannerNone   = "none"
+
+	// bannerEnvVar lets operators pick a banner, or turn it off,
+	// without touching code: SEALDB_BANNER=starwars|modular|none|random.
+	bannerEnvVar = "SEALDB_BANNER"
 )
 
-var banners = [3]string{
-	// modular
-	`
+// bannerFooter is rendered below the chosen ASCII art, so every
+// banner - built-in or registered by a downstream fork - reports the
+// same runtime fields.
+const bannerFooter = `version {{.Major}}.{{.Minor}}.{{.Patch}} ({{.GitTag}}, built {{.BuildTime}}) {{.GoVersion}} {{.Platform}}
+`
+
+// BannerRegistry holds named ASCII-art banners. Downstream forks and
+// tests register their own banners at init time instead of editing
+// this file, then select one by name (typically via SEALDB_BANNER).
+type BannerRegistry struct {
+	mu    sync.RWMutex
+	art   map[string]string
+	order []string
+	rng   *rand.Rand
+}
+
+// NewBannerRegistry returns an empty registry with its own random
+// source, seeded once rather than on every Render call.
+func NewBannerRegistry() *BannerRegistry {
+	return &BannerRegistry{
+		art: make(map[string]string),
+		rng: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Register adds or replaces the banner stored under name. It is safe
+// to call from an init func.
+func (r *BannerRegistry) Register(name, art string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.art[name]; !ok {
+		r.order = append(r.order, name)
+	}
+	r.art[name] = art
+}
+
+// Names returns the registered banner names in registration order.
+func (r *BannerRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, len(r.order))
+	copy(names, r.order)
+	return names
+}
+
+// Render returns the chosen banner's ASCII art with the runtime
+// footer appended. name may be a registered banner name, BannerRandom
+// (or "") to pick one of the registered banners at random, or
+// BannerNone to render nothing. An unregistered name, or a random pick
+// from an empty registry, also renders nothing.
+func (r *BannerRegistry) Render(name string) (string, error) {
+	art, ok := r.lookup(name)
+	if !ok {
+		return "", nil
+	}
+
+	tmpl, err := template.New("banner-footer").Parse(bannerFooter)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(art)
+	if err := tmpl.Execute(&buf, GetVersion()); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (r *BannerRegistry) lookup(name string) (string, bool) {
+	// Lock (not RLock): the random branch below calls r.rng.Intn,
+	// which mutates *rand.Rand's internal state and is not safe for
+	// concurrent use.
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch name {
+	case BannerNone:
+		return "", false
+	case "", BannerRandom:
+		if len(r.order) == 0 {
+			return "", false
+		}
+		return r.art[r.order[r.rng.Intn(len(r.order))]], true
+	default:
+		art, ok := r.art[name]
+		return art, ok
+	}
+}
+
+// WriteTo renders the named banner to w, colorizing it when w is a
+// terminal. It is the single code path the server startup and the
+// demo main share, so both print identical banners.
+func (r *BannerRegistry) WriteTo(w io.Writer, name string) (int, error) {
+	s, err := r.Render(name)
+	if err != nil || s == "" {
+		return 0, err
+	}
+	if f, ok := w.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		s = colorize(s)
+	}
+	return io.WriteString(w, s)
+}
+
+// colorize wraps s in the cyan ANSI escape sequence sealdb uses for
+// startup banners.
+func colorize(s string) string {
+	const cyan = "\x1b[36m"
+	const reset = "\x1b[0m"
+	return cyan + s + reset
+}
+
+// defaultRegistry is populated with sealdb's built-in banners and is
+// what GetBanner renders from.
+var defaultRegistry = NewBannerRegistry()
+
+func init() {
+	defaultRegistry.Register("modular", `
  _______  _______  _______  ___      ______   _______
 |       ||       ||   _   ||   |    |      | |  _    |
 |  _____||    ___||  |_|  ||   |    |  _    || |_|   |
@@ -31,8 +164,8 @@ var banners = [3]string{
 |_____  ||    ___||       ||   |___ | |_|   ||  _   |
  _____| ||   |___ |   _   ||       ||       || |_|   |
 |_______||_______||__| |__||_______||______| |_______|
-`,
-	`
+`)
+	defaultRegistry.Register("classic", `
  _______  _______  _______  _        ______   ______
 (  ____ \(  ____ \(  ___  )( \      (  __  \ (  ___ \
 | (    \/| (    \/| (   ) || (      | (  \  )| (   ) )
@@ -41,19 +174,36 @@ var banners = [3]string{
       ) || (      | (   ) || |      | |   ) || (  \ \
 /\____) || (____/\| )   ( || (____/\| (__/  )| )___) )
 \_______)(_______/|/     \|(_______/(______/ |/ \___/
-`,
-	// starwars
-	`
+`)
+	defaultRegistry.Register("starwars", `
      _______. _______     ___       __       _______  .______
     /       ||   ____|   /   \     |  |     |       \ |   _  \
    |   (----||  |__     /  ^  \    |  |     |  .--.  ||  |_)  |
     \   \    |   __|   /  /_\  \   |  |     |  |  |  ||   _  <
 .----)   |   |  |____ /  _____  \  |  |____ |  '--'  ||  |_)  |
 |_______/    |_______/__/     \__\ |_______||_______/ |______/
-`}
+`)
+}
+
+// DefaultBannerRegistry returns the registry holding sealdb's built-in
+// banners, so callers can Register additional ones alongside them.
+func DefaultBannerRegistry() *BannerRegistry {
+	return defaultRegistry
+}
+
+// BannerNameFromEnv reads SEALDB_BANNER, defaulting to BannerRandom
+// when unset.
+func BannerNameFromEnv() string {
+	if name := os.Getenv(bannerEnvVar); name != "" {
+		return name
+	}
+	return BannerRandom
+}
 
+// GetBanner returns the banner selected by SEALDB_BANNER, rendered
+// with the runtime footer. Kept for callers that want the plain
+// string instead of writing straight to an io.Writer via WriteTo.
 func GetBanner() *string {
-	rand.Seed(time.Now().UnixNano())
-	no := rand.Intn(3)
-	return &banners[no]
+	s, _ := defaultRegistry.Render(BannerNameFromEnv())
+	return &s
 }
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2022-2025 The Seal Authors.
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+*/
+
+package version
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestBannerRegistryRenderByName(t *testing.T) {
+	r := NewBannerRegistry()
+	r.Register("foo", "FOO-ART\n")
+
+	got, err := r.Render("foo")
+	if err != nil {
+		t.Fatalf("Render(%q) returned error: %v", "foo", err)
+	}
+	if !strings.HasPrefix(got, "FOO-ART\n") {
+		t.Errorf("Render(%q) = %q, want it to start with the registered art", "foo", got)
+	}
+}
+
+func TestBannerRegistryRenderNone(t *testing.T) {
+	r := NewBannerRegistry()
+	r.Register("foo", "FOO-ART\n")
+
+	got, err := r.Render(BannerNone)
+	if err != nil {
+		t.Fatalf("Render(%q) returned error: %v", BannerNone, err)
+	}
+	if got != "" {
+		t.Errorf("Render(%q) = %q, want empty string", BannerNone, got)
+	}
+}
+
+func TestBannerRegistryRenderUnknownName(t *testing.T) {
+	r := NewBannerRegistry()
+	r.Register("foo", "FOO-ART\n")
+
+	got, err := r.Render("does-not-exist")
+	if err != nil {
+		t.Fatalf("Render(%q) returned error: %v", "does-not-exist", err)
+	}
+	if got != "" {
+		t.Errorf("Render(%q) = %q, want empty string for an unregistered name", "does-not-exist", got)
+	}
+}
+
+func TestBannerRegistryRenderEmptyRegistry(t *testing.T) {
+	r := NewBannerRegistry()
+
+	for _, name := range []string{"", BannerRandom} {
+		got, err := r.Render(name)
+		if err != nil {
+			t.Fatalf("Render(%q) on an empty registry returned error: %v", name, err)
+		}
+		if got != "" {
+			t.Errorf("Render(%q) on an empty registry = %q, want empty string", name, got)
+		}
+	}
+}
+
+func TestBannerRegistryRenderRandomPicksRegistered(t *testing.T) {
+	r := NewBannerRegistry()
+	r.Register("foo", "FOO-ART\n")
+	r.Register("bar", "BAR-ART\n")
+
+	for i := 0; i < 20; i++ {
+		got, err := r.Render(BannerRandom)
+		if err != nil {
+			t.Fatalf("Render(%q) returned error: %v", BannerRandom, err)
+		}
+		if !strings.HasPrefix(got, "FOO-ART\n") && !strings.HasPrefix(got, "BAR-ART\n") {
+			t.Fatalf("Render(%q) = %q, want it to start with one of the registered banners", BannerRandom, got)
+		}
+	}
+}
+
+func TestBannerRegistryLookupConcurrentRandom(t *testing.T) {
+	r := NewBannerRegistry()
+	r.Register("foo", "FOO-ART\n")
+	r.Register("bar", "BAR-ART\n")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := r.Render(BannerRandom); err != nil {
+				t.Errorf("Render(%q) returned error: %v", BannerRandom, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestBannerRegistryNames(t *testing.T) {
+	r := NewBannerRegistry()
+	r.Register("foo", "FOO-ART\n")
+	r.Register("bar", "BAR-ART\n")
+
+	got := r.Names()
+	want := []string{"foo", "bar"}
+	if len(got) != len(want) {
+		t.Fatalf("Names() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Names()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
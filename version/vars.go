@@ -0,0 +1,36 @@
+/*
+ * Copyright 2022-2025 The Seal Authors.
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+*/
+
+package version
+
+// VersionVariables returns the subset of MySQL system variables whose
+// name starts with "version": the values a connection handler would
+// use to answer `SELECT @@version`, `SELECT @@version_comment` and
+// `SHOW VARIABLES LIKE 'version%'`, so all three report the same
+// numbers from one place.
+//
+// This tree has no MySQL connection handler yet (server/main.go only
+// runs the demo parser loop), so nothing decodes those queries off
+// the wire today; pkg/admin's /variables endpoint exposes the same
+// data over HTTP in the meantime. Once a handler exists, it should
+// call this instead of recomputing these fields.
+func (v *Version) VersionVariables() map[string]string {
+	return map[string]string{
+		"version":            v.ServerVersionString(),
+		"version_comment":    VersionComment,
+		"version_compile_os": v.Platform,
+	}
+}
@@ -67,6 +67,27 @@ func GetVersion() *Version {
 	}
 }
 
+// ServerVersionString returns the string sealdb reports as the MySQL
+// server_version, e.g. "8.0.29-sealdb-1.0.0-Not provided". The MySQL
+// client libraries parse the leading "major.minor.patch" to decide
+// protocol capabilities, so that part must stay first.
+//
+// KNOWN GAP: this tree has no MySQL connection/handshake code, so
+// nothing actually sends this string to a client yet, and there's no
+// query path that answers SELECT @@version or SHOW VARIABLES LIKE
+// 'version%'. pkg/admin's /variables endpoint surfaces VersionVariables
+// over HTTP as a stand-in. Whoever adds the handshake/connection layer
+// should have it call ServerVersionString and VersionVariables
+// directly instead of recomputing these fields.
+func (v *Version) ServerVersionString() string {
+	return fmt.Sprintf("%d.%d.%d-%s-%d.%d.%d-%s",
+		v.MysqlMajor, v.MysqlMinor, v.MysqlPatch,
+		v.ProjectName, v.Major, v.Minor, v.Patch, v.GitTag)
+}
+
+// VersionComment is reported as @@version_comment.
+const VersionComment = "sealdb"
+
 //func GetVersion() string {
 //	return fmt.Sprintf("%d.%d.%d", Major, Minor, Patch)
 //}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2022-2025 The Seal Authors.
+
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+*/
+
+package version
+
+import "testing"
+
+func TestServerVersionString(t *testing.T) {
+	v := &Version{
+		ProjectName: "sealdb",
+		Major:       1,
+		Minor:       2,
+		Patch:       3,
+		MysqlMajor:  8,
+		MysqlMinor:  0,
+		MysqlPatch:  29,
+		GitTag:      "v1.2.3",
+	}
+
+	want := "8.0.29-sealdb-1.2.3-v1.2.3"
+	if got := v.ServerVersionString(); got != want {
+		t.Errorf("ServerVersionString() = %q, want %q", got, want)
+	}
+}
+
+func TestVersionVariables(t *testing.T) {
+	v := &Version{
+		ProjectName: "sealdb",
+		Major:       1,
+		Minor:       2,
+		Patch:       3,
+		MysqlMajor:  8,
+		MysqlMinor:  0,
+		MysqlPatch:  29,
+		GitTag:      "v1.2.3",
+		Platform:    "linux/amd64",
+	}
+
+	vars := v.VersionVariables()
+	want := map[string]string{
+		"version":            v.ServerVersionString(),
+		"version_comment":    VersionComment,
+		"version_compile_os": "linux/amd64",
+	}
+	for name, wantVal := range want {
+		if got := vars[name]; got != wantVal {
+			t.Errorf("VersionVariables()[%q] = %q, want %q", name, got, wantVal)
+		}
+	}
+}